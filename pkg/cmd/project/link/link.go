@@ -20,6 +20,8 @@ type linkOpts struct {
 	owner     string
 	repo      string
 	team      string
+	fromFile  string
+	dryRun    bool
 	projectID string
 	repoID    string
 	teamID    string
@@ -57,6 +59,12 @@ func NewCmdLink(f *cmdutil.Factory, runF func(config linkConfig) error) *cobra.C
 
 			# link monalisa's organization's project 1 to her team "my_team"
 			gh project link 1 --owner my_organization --team my_team
+
+			# link many repositories and teams to one or more projects from a manifest
+			gh project link --from-file manifest.yml
+
+			# preview the mutation that would be sent, without linking anything
+			gh project link 1 --owner monalisa --repo my_repo --dry-run
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			client, err := client.New(f)
@@ -79,7 +87,11 @@ func NewCmdLink(f *cmdutil.Factory, runF func(config linkConfig) error) *cobra.C
 				io:         f.IOStreams,
 			}
 
-			if config.opts.repo != "" && config.opts.team != "" {
+			if config.opts.fromFile != "" {
+				if config.opts.repo != "" || config.opts.team != "" {
+					return fmt.Errorf("specify either `--from-file` or `--repo`/`--team`, not both")
+				}
+			} else if config.opts.repo != "" && config.opts.team != "" {
 				return fmt.Errorf("specify only one of `--repo` or `--team`")
 			} else if config.opts.repo == "" && config.opts.team == "" {
 				return fmt.Errorf("specify either `--repo` or `--team`")
@@ -96,74 +108,108 @@ func NewCmdLink(f *cmdutil.Factory, runF func(config linkConfig) error) *cobra.C
 	linkCmd.Flags().StringVar(&opts.owner, "owner", "", "Login of the owner. Use \"@me\" for the current user.")
 	linkCmd.Flags().StringVarP(&opts.repo, "repo", "R", "", "The repository to be linked to this project")
 	linkCmd.Flags().StringVarP(&opts.team, "team", "T", "", "The team to be linked to this project")
+	linkCmd.Flags().StringVar(&opts.fromFile, "from-file", "", "Link many repositories and teams to one or more projects from a YAML or JSON manifest")
+	linkCmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "Preview the mutation that would be sent without linking anything")
 	cmdutil.AddFormatFlags(linkCmd, &opts.exporter)
 
 	return linkCmd
 }
 
 func runLink(config linkConfig) error {
-	canPrompt := config.io.CanPrompt()
-	owner, err := config.client.NewOwner(canPrompt, config.opts.owner)
+	if config.opts.fromFile != "" {
+		return runLinkFromFile(config)
+	}
+
+	owner, project, c, err := config.client.ResolveOwnerProject(config.io, config.httpClient, config.opts.owner, config.opts.number)
 	if err != nil {
 		return err
 	}
+	config.opts.projectID = project.ID
+
+	if config.opts.repo != "" {
+		return linkRepo(c, owner, project, config)
+	} else if config.opts.team != "" {
+		return linkTeam(owner, project, config)
+	}
+	return nil
+}
 
-	project, err := config.client.NewProject(canPrompt, owner, config.opts.number, false)
+func linkRepo(c *api.Client, owner *queries.Owner, project *queries.Project, config linkConfig) error {
+	repository, plan, err := linkRepoMutation(c, owner, project, config)
 	if err != nil {
 		return err
 	}
-	config.opts.projectID = project.ID
+	if plan != nil {
+		return printDryRunPlan(config, *plan)
+	}
 
-	httpClient, err := config.httpClient()
+	if config.opts.exporter != nil {
+		return config.opts.exporter.Write(config.io, repository)
+	}
+	return printResults(config, repository.URL)
+}
+
+func linkTeam(owner *queries.Owner, project *queries.Project, config linkConfig) error {
+	team, plan, err := linkTeamMutation(owner, project, config)
 	if err != nil {
 		return err
 	}
-	c := api.NewClientFromHTTP(httpClient)
+	if plan != nil {
+		return printDryRunPlan(config, *plan)
+	}
 
-	if config.opts.repo != "" {
-		return linkRepo(c, owner, config)
-	} else if config.opts.team != "" {
-		return linkTeam(c, owner, config)
+	if config.opts.exporter != nil {
+		return config.opts.exporter.Write(config.io, team)
 	}
-	return nil
+	return printResults(config, team.URL)
 }
 
-func linkRepo(c *api.Client, owner *queries.Owner, config linkConfig) error {
+// linkRepoMutation links config.opts.repo to project, returning the linked
+// repository. With config.opts.dryRun set, it returns a non-nil plan instead
+// of calling config.client.Mutate.
+func linkRepoMutation(c *api.Client, owner *queries.Owner, project *queries.Project, config linkConfig) (queries.Repository, *linkPlan, error) {
 	repo, err := api.GitHubRepo(c, ghrepo.New(owner.Login, config.opts.repo))
 	if err != nil {
-		return err
+		return queries.Repository{}, nil, err
 	}
 	config.opts.repoID = repo.ID
 
 	query, variable := linkRepoArgs(config)
-	err = config.client.Mutate("LinkProjectV2ToRepository", query, variable)
-	if err != nil {
-		return err
+	if config.opts.dryRun {
+		target := ghrepo.New(owner.Login, config.opts.repo)
+		plan := linkPlan{Mutation: "LinkProjectV2ToRepository", Project: project.Title, Target: ghrepo.FullName(target), Variables: variable}
+		return queries.Repository{}, &plan, nil
 	}
 
-	if config.opts.exporter != nil {
-		return config.opts.exporter.Write(config.io, query.LinkProjectV2ToRepository.Repository)
+	if err := config.client.Mutate("LinkProjectV2ToRepository", query, variable); err != nil {
+		return queries.Repository{}, nil, err
 	}
-	return printResults(config, query.LinkProjectV2ToRepository.Repository.URL)
+	return query.LinkProjectV2ToRepository.Repository, nil, nil
 }
 
-func linkTeam(c *api.Client, owner *queries.Owner, config linkConfig) error {
-	team, err := api.OrganizationTeam(c, ghrepo.New(owner.Login, ""), config.opts.team)
+// linkTeamMutation links config.opts.team to project, returning the linked
+// team. config.opts.team is resolved via queries.ResolveTeam, so it may be a
+// bare slug, an `org/team` path, a nested `org/team/child` path, or an `id:`
+// prefixed team node ID. With config.opts.dryRun set, it returns a non-nil plan
+// instead of calling config.client.Mutate.
+func linkTeamMutation(owner *queries.Owner, project *queries.Project, config linkConfig) (queries.Team, *linkPlan, error) {
+	team, err := config.client.ResolveTeam(owner.Login, config.opts.team)
 	if err != nil {
-		return err
+		return queries.Team{}, nil, err
 	}
 	config.opts.teamID = team.ID
 
 	query, variable := linkTeamArgs(config)
-	err = config.client.Mutate("LinkProjectV2ToTeam", query, variable)
-	if err != nil {
-		return err
+	if config.opts.dryRun {
+		target := fmt.Sprintf("%s/%s", owner.Login, team.Slug)
+		plan := linkPlan{Mutation: "LinkProjectV2ToTeam", Project: project.Title, Target: target, Variables: variable}
+		return queries.Team{}, &plan, nil
 	}
 
-	if config.opts.exporter != nil {
-		return config.opts.exporter.Write(config.io, query.LinkProjectV2ToTeam.Team)
+	if err := config.client.Mutate("LinkProjectV2ToTeam", query, variable); err != nil {
+		return queries.Team{}, nil, err
 	}
-	return printResults(config, query.LinkProjectV2ToTeam.Team.URL)
+	return query.LinkProjectV2ToTeam.Team, nil, nil
 }
 
 func linkRepoArgs(config linkConfig) (*linkProjectToRepoMutation, map[string]interface{}) {
@@ -0,0 +1,233 @@
+package link
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/queries"
+	"golang.org/x/sync/errgroup"
+	"gopkg.in/yaml.v3"
+)
+
+// linkManifestWorkers bounds how many link mutations run concurrently when
+// processing a --from-file manifest, so a large manifest doesn't hammer the
+// API all at once.
+const linkManifestWorkers = 5
+
+// linkManifestEntry describes the repos and teams to link to a single
+// project, as specified in a --from-file manifest.
+type linkManifestEntry struct {
+	Project int32    `json:"project" yaml:"project"`
+	Owner   string   `json:"owner" yaml:"owner"`
+	Repos   []string `json:"repos" yaml:"repos"`
+	Teams   []string `json:"teams" yaml:"teams"`
+}
+
+// linkResult is the outcome of linking a single repo or team to a project,
+// reported back to the user once a --from-file run has finished.
+type linkResult struct {
+	Project string    `json:"project"`
+	Owner   string    `json:"owner"`
+	Target  string    `json:"target"`
+	Kind    string    `json:"kind"`
+	Status  string    `json:"status"`
+	URL     string    `json:"url,omitempty"`
+	Error   string    `json:"error,omitempty"`
+	Plan    *linkPlan `json:"plan,omitempty"`
+}
+
+func (lr linkResult) ExportData(_ []string) map[string]interface{} {
+	return map[string]interface{}{
+		"project": lr.Project,
+		"owner":   lr.Owner,
+		"target":  lr.Target,
+		"kind":    lr.Kind,
+		"status":  lr.Status,
+		"url":     lr.URL,
+		"error":   lr.Error,
+		"plan":    lr.Plan,
+	}
+}
+
+// runLinkFromFile fans out the link mutations described by a --from-file
+// manifest across a bounded worker pool.
+func runLinkFromFile(config linkConfig) error {
+	data, err := os.ReadFile(config.opts.fromFile)
+	if err != nil {
+		return fmt.Errorf("failed to read manifest file: %w", err)
+	}
+
+	var entries []linkManifestEntry
+	if err := yaml.Unmarshal(data, &entries); err != nil {
+		return fmt.Errorf("failed to parse manifest file %q: %w", config.opts.fromFile, err)
+	}
+
+	httpClient, err := config.httpClient()
+	if err != nil {
+		return err
+	}
+	c := api.NewClientFromHTTP(httpClient)
+
+	jobs, err := buildLinkJobs(config, c, entries)
+	if err != nil {
+		return err
+	}
+
+	results := make([]linkResult, len(jobs))
+	g := new(errgroup.Group)
+	g.SetLimit(linkManifestWorkers)
+	for i, job := range jobs {
+		i, job := i, job
+		g.Go(func() error {
+			results[i] = job()
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	return reportLinkResults(config, results)
+}
+
+// buildLinkJobs resolves the owner and project for every manifest entry and
+// returns one closure per repo/team target to run on the worker pool.
+func buildLinkJobs(config linkConfig, c *api.Client, entries []linkManifestEntry) ([]func() linkResult, error) {
+	canPrompt := config.io.CanPrompt()
+
+	type projectKey struct {
+		owner  string
+		number int32
+	}
+	owners := map[string]*queries.Owner{}
+	projects := map[projectKey]*queries.Project{}
+
+	resolveProject := func(ownerLogin string, number int32) (*queries.Owner, *queries.Project, error) {
+		owner, ok := owners[ownerLogin]
+		if !ok {
+			var err error
+			owner, err = config.client.NewOwner(canPrompt, ownerLogin)
+			if err != nil {
+				return nil, nil, err
+			}
+			owners[ownerLogin] = owner
+		}
+
+		key := projectKey{owner: ownerLogin, number: number}
+		project, ok := projects[key]
+		if !ok {
+			var err error
+			project, err = config.client.NewProject(canPrompt, owner, number, false)
+			if err != nil {
+				return nil, nil, err
+			}
+			projects[key] = project
+		}
+		return owner, project, nil
+	}
+
+	var jobs []func() linkResult
+	for _, entry := range entries {
+		entry := entry
+		ownerLogin := entry.Owner
+		if ownerLogin == "" {
+			ownerLogin = config.opts.owner
+		}
+
+		owner, project, err := resolveProject(ownerLogin, entry.Project)
+		if err != nil {
+			for _, target := range dedupe(append(entry.Repos, entry.Teams...)) {
+				target := target
+				jobs = append(jobs, func() linkResult {
+					return linkResult{Owner: ownerLogin, Target: target, Status: "failed", Error: err.Error()}
+				})
+			}
+			continue
+		}
+
+		for _, repoName := range dedupe(entry.Repos) {
+			repoName := repoName
+			jobs = append(jobs, func() linkResult {
+				entryConfig := config
+				entryConfig.opts.repo = repoName
+				entryConfig.opts.team = ""
+				entryConfig.opts.projectID = project.ID
+				repo, plan, err := linkRepoMutation(c, owner, project, entryConfig)
+				return newLinkResult(project.Title, ownerLogin, repoName, "repo", repo.URL, plan, err)
+			})
+		}
+
+		for _, teamName := range dedupe(entry.Teams) {
+			teamName := teamName
+			jobs = append(jobs, func() linkResult {
+				entryConfig := config
+				entryConfig.opts.team = teamName
+				entryConfig.opts.repo = ""
+				entryConfig.opts.projectID = project.ID
+				team, plan, err := linkTeamMutation(owner, project, entryConfig)
+				return newLinkResult(project.Title, ownerLogin, teamName, "team", team.URL, plan, err)
+			})
+		}
+	}
+
+	return jobs, nil
+}
+
+// newLinkResult builds the per-target report entry for a completed link
+// attempt. A non-nil plan (--dry-run) always takes precedence over err/url.
+func newLinkResult(project, owner, target, kind, url string, plan *linkPlan, err error) linkResult {
+	if plan != nil {
+		return linkResult{Project: project, Owner: owner, Target: target, Kind: kind, Status: "dry-run", Plan: plan}
+	}
+	if err != nil {
+		return linkResult{Project: project, Owner: owner, Target: target, Kind: kind, Status: "failed", Error: err.Error()}
+	}
+	return linkResult{Project: project, Owner: owner, Target: target, Kind: kind, Status: "linked", URL: url}
+}
+
+// reportLinkResults prints or exports the outcome of a --from-file run. It
+// only returns an error when every target failed.
+func reportLinkResults(config linkConfig, results []linkResult) error {
+	failed := 0
+	for _, result := range results {
+		if result.Status == "failed" {
+			failed++
+		}
+	}
+
+	if config.opts.exporter != nil {
+		if err := config.opts.exporter.Write(config.io, results); err != nil {
+			return err
+		}
+	} else {
+		for _, result := range results {
+			switch {
+			case result.Status == "failed":
+				fmt.Fprintf(config.io.ErrOut, "X %s/%s (%s): %s\n", result.Owner, result.Target, result.Kind, result.Error)
+			case result.Status == "dry-run":
+				if config.io.IsStdoutTTY() {
+					fmt.Fprintf(config.io.Out, "would link %s/%s (%s) to project %q\n", result.Owner, result.Target, result.Kind, result.Project)
+				}
+			case config.io.IsStdoutTTY():
+				fmt.Fprintf(config.io.Out, "✓ %s/%s (%s): %s\n", result.Owner, result.Target, result.Kind, result.URL)
+			}
+		}
+	}
+
+	if len(results) > 0 && failed == len(results) {
+		return fmt.Errorf("failed to link all %d target(s)", failed)
+	}
+	return nil
+}
+
+func dedupe(items []string) []string {
+	seen := make(map[string]bool, len(items))
+	out := make([]string, 0, len(items))
+	for _, item := range items {
+		if seen[item] {
+			continue
+		}
+		seen[item] = true
+		out = append(out, item)
+	}
+	return out
+}
@@ -0,0 +1,104 @@
+package link
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeExporter is a no-op cmdutil.Exporter used to exercise the --format
+// json path of reportLinkResults without a real JSON exporter.
+type fakeExporter struct{}
+
+func (fakeExporter) Fields() []string { return nil }
+
+func (fakeExporter) Write(*iostreams.IOStreams, interface{}) error { return nil }
+
+func TestDedupe(t *testing.T) {
+	tests := []struct {
+		name  string
+		items []string
+		want  []string
+	}{
+		{name: "empty", items: nil, want: []string{}},
+		{name: "no duplicates", items: []string{"a", "b"}, want: []string{"a", "b"}},
+		{name: "duplicates collapse to first occurrence", items: []string{"a", "b", "a", "c", "b"}, want: []string{"a", "b", "c"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, dedupe(tt.items))
+		})
+	}
+}
+
+func TestReportLinkResults(t *testing.T) {
+	tests := []struct {
+		name       string
+		results    []linkResult
+		exporter   bool
+		wantErr    string
+		wantErrOut string
+	}{
+		{
+			name: "all succeed",
+			results: []linkResult{
+				{Owner: "monalisa", Target: "repo-a", Kind: "repo", Status: "linked", URL: "https://github.com/monalisa/repo-a"},
+				{Owner: "monalisa", Target: "team-a", Kind: "team", Status: "linked", URL: "https://github.com/orgs/monalisa/teams/team-a"},
+			},
+		},
+		{
+			name: "partial failure does not error",
+			results: []linkResult{
+				{Owner: "monalisa", Target: "repo-a", Kind: "repo", Status: "linked", URL: "https://github.com/monalisa/repo-a"},
+				{Owner: "monalisa", Target: "repo-b", Kind: "repo", Status: "failed", Error: "not found"},
+			},
+			wantErrOut: "not found",
+		},
+		{
+			name: "every target failing errors",
+			results: []linkResult{
+				{Owner: "monalisa", Target: "repo-a", Kind: "repo", Status: "failed", Error: "not found"},
+				{Owner: "monalisa", Target: "repo-b", Kind: "repo", Status: "failed", Error: "not found"},
+			},
+			wantErr: "failed to link all 2 target(s)",
+		},
+		{
+			name:    "no targets does not error",
+			results: nil,
+		},
+		{
+			name: "every target failing still errors with --format json",
+			results: []linkResult{
+				{Owner: "monalisa", Target: "repo-a", Kind: "repo", Status: "failed", Error: "not found"},
+				{Owner: "monalisa", Target: "repo-b", Kind: "repo", Status: "failed", Error: "not found"},
+			},
+			exporter: true,
+			wantErr:  "failed to link all 2 target(s)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, stderr := iostreams.Test()
+			ios.SetStdoutTTY(true)
+			config := linkConfig{io: ios}
+			if tt.exporter {
+				config.opts.exporter = fakeExporter{}
+			}
+
+			err := reportLinkResults(config, tt.results)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			if tt.wantErrOut != "" {
+				assert.True(t, strings.Contains(stderr.String(), tt.wantErrOut))
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package link
+
+import "fmt"
+
+// linkPlan is the machine-readable form of a --dry-run preview.
+type linkPlan struct {
+	Mutation  string                 `json:"mutation"`
+	Project   string                 `json:"project"`
+	Target    string                 `json:"target"`
+	Variables map[string]interface{} `json:"variables"`
+}
+
+func (lp linkPlan) ExportData(_ []string) map[string]interface{} {
+	return map[string]interface{}{
+		"mutation":  lp.Mutation,
+		"project":   lp.Project,
+		"target":    lp.Target,
+		"variables": lp.Variables,
+	}
+}
+
+// printDryRunPlan prints, or with --format json exports, a single-target
+// --dry-run plan.
+func printDryRunPlan(config linkConfig, plan linkPlan) error {
+	if config.opts.exporter != nil {
+		return config.opts.exporter.Write(config.io, plan)
+	}
+
+	fmt.Fprintf(config.io.Out, "would run %s for project %q, target %s\n", plan.Mutation, plan.Project, plan.Target)
+	for name, value := range plan.Variables {
+		fmt.Fprintf(config.io.Out, "  %s: %+v\n", name, value)
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package link
+
+import (
+	"testing"
+
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrintDryRunPlan(t *testing.T) {
+	plan := linkPlan{
+		Mutation:  "LinkProjectV2ToRepository",
+		Project:   "My Project",
+		Target:    "monalisa/my_repo",
+		Variables: map[string]interface{}{"input": "some-input"},
+	}
+
+	ios, _, stdout, _ := iostreams.Test()
+	config := linkConfig{io: ios}
+
+	err := printDryRunPlan(config, plan)
+	assert.NoError(t, err)
+	assert.Contains(t, stdout.String(), `would run LinkProjectV2ToRepository for project "My Project", target monalisa/my_repo`)
+	assert.Contains(t, stdout.String(), "input: some-input")
+}
+
+func TestLinkPlanExportData(t *testing.T) {
+	plan := linkPlan{
+		Mutation:  "LinkProjectV2ToTeam",
+		Project:   "My Project",
+		Target:    "monalisa/my_team",
+		Variables: map[string]interface{}{"input": "some-input"},
+	}
+
+	data := plan.ExportData(nil)
+	assert.Equal(t, "LinkProjectV2ToTeam", data["mutation"])
+	assert.Equal(t, "My Project", data["project"])
+	assert.Equal(t, "monalisa/my_team", data["target"])
+	assert.Equal(t, plan.Variables, data["variables"])
+}
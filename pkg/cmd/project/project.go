@@ -0,0 +1,20 @@
+package project
+
+import (
+	"github.com/cli/cli/v2/pkg/cmd/project/link"
+	"github.com/cli/cli/v2/pkg/cmd/project/unlink"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdProject(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "project <command>",
+		Short: "Work with GitHub Projects.",
+	}
+
+	cmd.AddCommand(link.NewCmdLink(f, nil))
+	cmd.AddCommand(unlink.NewCmdUnlink(f, nil))
+
+	return cmd
+}
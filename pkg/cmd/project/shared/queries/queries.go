@@ -0,0 +1,107 @@
+package queries
+
+import "github.com/shurcooL/githubv4"
+
+// ProjectRepository is a repository linked to a ProjectV2, as returned by the
+// ProjectV2.repositories connection.
+type ProjectRepository struct {
+	ID            string
+	NameWithOwner string
+}
+
+// ProjectTeam is a team linked to a ProjectV2, as returned by the
+// ProjectV2.teams connection.
+type ProjectTeam struct {
+	ID   string
+	Slug string
+}
+
+type projectRepositoriesQuery struct {
+	Node struct {
+		ProjectV2 struct {
+			Repositories struct {
+				Nodes []struct {
+					ID            githubv4.ID
+					NameWithOwner string
+				}
+				PageInfo struct {
+					HasNextPage bool
+					EndCursor   string
+				}
+			} `graphql:"repositories(first: 100, after: $endCursor)"`
+		} `graphql:"... on ProjectV2"`
+	} `graphql:"node(id: $id)"`
+}
+
+type projectTeamsQuery struct {
+	Node struct {
+		ProjectV2 struct {
+			Teams struct {
+				Nodes []struct {
+					ID   githubv4.ID
+					Slug string
+				}
+				PageInfo struct {
+					HasNextPage bool
+					EndCursor   string
+				}
+			} `graphql:"teams(first: 100, after: $endCursor)"`
+		} `graphql:"... on ProjectV2"`
+	} `graphql:"node(id: $id)"`
+}
+
+// ProjectRepositories returns every repository currently linked to project,
+// paging through the ProjectV2.repositories connection.
+func (c *Client) ProjectRepositories(project *Project) ([]ProjectRepository, error) {
+	var repos []ProjectRepository
+	variables := map[string]interface{}{
+		"id":        githubv4.ID(project.ID),
+		"endCursor": (*string)(nil),
+	}
+
+	for {
+		var query projectRepositoriesQuery
+		if err := c.Query("ProjectRepositories", &query, variables); err != nil {
+			return nil, err
+		}
+
+		for _, node := range query.Node.ProjectV2.Repositories.Nodes {
+			repos = append(repos, ProjectRepository{ID: string(node.ID), NameWithOwner: node.NameWithOwner})
+		}
+
+		if !query.Node.ProjectV2.Repositories.PageInfo.HasNextPage {
+			break
+		}
+		variables["endCursor"] = githubv4.String(query.Node.ProjectV2.Repositories.PageInfo.EndCursor)
+	}
+
+	return repos, nil
+}
+
+// ProjectTeams returns every team currently linked to project, paging
+// through the ProjectV2.teams connection.
+func (c *Client) ProjectTeams(project *Project) ([]ProjectTeam, error) {
+	var teams []ProjectTeam
+	variables := map[string]interface{}{
+		"id":        githubv4.ID(project.ID),
+		"endCursor": (*string)(nil),
+	}
+
+	for {
+		var query projectTeamsQuery
+		if err := c.Query("ProjectTeams", &query, variables); err != nil {
+			return nil, err
+		}
+
+		for _, node := range query.Node.ProjectV2.Teams.Nodes {
+			teams = append(teams, ProjectTeam{ID: string(node.ID), Slug: node.Slug})
+		}
+
+		if !query.Node.ProjectV2.Teams.PageInfo.HasNextPage {
+			break
+		}
+		variables["endCursor"] = githubv4.String(query.Node.ProjectV2.Teams.PageInfo.EndCursor)
+	}
+
+	return teams, nil
+}
@@ -0,0 +1,31 @@
+package queries
+
+import (
+	"net/http"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/pkg/iostreams"
+)
+
+// ResolveOwnerProject resolves ownerLogin and number into an Owner and
+// Project, and builds the REST api.Client used for the repository lookups
+// that back link and unlink mutations.
+func (c *Client) ResolveOwnerProject(io *iostreams.IOStreams, httpClient func() (*http.Client, error), ownerLogin string, number int32) (*Owner, *Project, *api.Client, error) {
+	canPrompt := io.CanPrompt()
+	owner, err := c.NewOwner(canPrompt, ownerLogin)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	project, err := c.NewProject(canPrompt, owner, number, false)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	hc, err := httpClient()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return owner, project, api.NewClientFromHTTP(hc), nil
+}
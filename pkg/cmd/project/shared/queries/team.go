@@ -0,0 +1,144 @@
+package queries
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/shurcooL/githubv4"
+)
+
+type teamBySlugQuery struct {
+	Organization struct {
+		Team struct {
+			ID  githubv4.ID
+			URL string
+		} `graphql:"team(slug: $slug)"`
+	} `graphql:"organization(login: $login)"`
+}
+
+type teamChildrenQuery struct {
+	Node struct {
+		Team struct {
+			ChildTeams struct {
+				Nodes []struct {
+					ID   githubv4.ID
+					Slug string
+					URL  string
+				}
+			} `graphql:"childTeams(first: 100)"`
+		} `graphql:"... on Team"`
+	} `graphql:"node(id: $id)"`
+}
+
+type teamByIDQuery struct {
+	Node struct {
+		Team struct {
+			ID   githubv4.ID
+			Slug string
+			URL  string
+		} `graphql:"... on Team"`
+	} `graphql:"node(id: $id)"`
+}
+
+// ResolveTeam resolves a --team value into a Team, accepting any of:
+//   - a bare slug under ownerLogin ("platform")
+//   - an "org/slug" path ("acme/platform")
+//   - a nested path naming a child team ("acme/platform/backend"), walked via
+//     the childTeams connection
+//   - a team's GraphQL node ID with an "id:" prefix ("id:T_kwDOAB"), looked
+//     up as-is via node(id:); a numeric database ID is not converted
+//
+// It is shared by project link and unlink so they both key teams the same
+// way.
+func (c *Client) ResolveTeam(ownerLogin, team string) (*Team, error) {
+	id, org, slugs, err := parseTeamRef(ownerLogin, team)
+	if err != nil {
+		return nil, err
+	}
+	if id != "" {
+		return c.teamByID(id)
+	}
+
+	var root teamBySlugQuery
+	if err := c.Query("TeamBySlug", &root, map[string]interface{}{
+		"login": githubv4.String(org),
+		"slug":  githubv4.String(slugs[0]),
+	}); err != nil {
+		return nil, teamResolveError(org, err)
+	}
+	if root.Organization.Team.ID == "" {
+		return nil, fmt.Errorf("team %q not found in organization %q", slugs[0], org)
+	}
+	current := &Team{ID: string(root.Organization.Team.ID), Slug: slugs[0], URL: root.Organization.Team.URL}
+
+	for _, childSlug := range slugs[1:] {
+		var children teamChildrenQuery
+		if err := c.Query("TeamChildren", &children, map[string]interface{}{
+			"id": githubv4.ID(current.ID),
+		}); err != nil {
+			return nil, teamResolveError(org, err)
+		}
+
+		child, ok := findChildTeam(children.Node.Team.ChildTeams.Nodes, childSlug)
+		if !ok {
+			return nil, fmt.Errorf("no child team %q found under team %q", childSlug, current.Slug)
+		}
+		current = child
+	}
+
+	return current, nil
+}
+
+// parseTeamRef parses a --team value into either a team node ID (idRef
+// non-empty) or an organization plus the path of one or more team slugs to
+// walk via childTeams. It does no network I/O, so ResolveTeam's parsing of
+// slugs, org/slug paths, nested paths, and the id: prefix can be tested
+// without a GraphQL round-trip.
+func parseTeamRef(ownerLogin, team string) (idRef string, org string, slugs []string, err error) {
+	if id, ok := strings.CutPrefix(team, "id:"); ok {
+		if id == "" {
+			return "", "", nil, fmt.Errorf("team %q must be a slug, an `org/team` path, a nested `org/team/child` path, or `id:<team-id>`", team)
+		}
+		return id, "", nil, nil
+	}
+
+	parts := strings.Split(team, "/")
+	org, slugs = ownerLogin, parts
+	if len(parts) > 1 {
+		org, slugs = parts[0], parts[1:]
+	}
+	if org == "" || len(slugs) == 0 || slugs[0] == "" {
+		return "", "", nil, fmt.Errorf("team %q must be a slug, an `org/team` path, a nested `org/team/child` path, or `id:<team-id>`", team)
+	}
+	return "", org, slugs, nil
+}
+
+func (c *Client) teamByID(id string) (*Team, error) {
+	var query teamByIDQuery
+	if err := c.Query("TeamByID", &query, map[string]interface{}{
+		"id": githubv4.ID(id),
+	}); err != nil {
+		return nil, fmt.Errorf("failed to resolve team id:%s: %w", id, err)
+	}
+	if query.Node.Team.ID == "" {
+		return nil, fmt.Errorf("team %q not found", "id:"+id)
+	}
+	return &Team{ID: string(query.Node.Team.ID), Slug: query.Node.Team.Slug, URL: query.Node.Team.URL}, nil
+}
+
+func findChildTeam(nodes []struct {
+	ID   githubv4.ID
+	Slug string
+	URL  string
+}, slug string) (*Team, bool) {
+	for _, node := range nodes {
+		if node.Slug == slug {
+			return &Team{ID: string(node.ID), Slug: node.Slug, URL: node.URL}, true
+		}
+	}
+	return nil, false
+}
+
+func teamResolveError(org string, err error) error {
+	return fmt.Errorf("failed to resolve team for organization %q, make sure you have the `read:org` scope: %w", org, err)
+}
@@ -0,0 +1,88 @@
+package queries
+
+import (
+	"testing"
+
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTeamRef(t *testing.T) {
+	tests := []struct {
+		name       string
+		ownerLogin string
+		team       string
+		wantID     string
+		wantOrg    string
+		wantSlugs  []string
+		wantErr    string
+	}{
+		{
+			name:       "bare slug under owner",
+			ownerLogin: "acme",
+			team:       "platform",
+			wantOrg:    "acme",
+			wantSlugs:  []string{"platform"},
+		},
+		{
+			name:       "org/slug path",
+			ownerLogin: "acme",
+			team:       "other-org/platform",
+			wantOrg:    "other-org",
+			wantSlugs:  []string{"platform"},
+		},
+		{
+			name:       "nested org/slug/child path",
+			ownerLogin: "acme",
+			team:       "acme/platform/backend",
+			wantOrg:    "acme",
+			wantSlugs:  []string{"platform", "backend"},
+		},
+		{
+			name:       "numeric id prefix",
+			ownerLogin: "acme",
+			team:       "id:12345",
+			wantID:     "12345",
+		},
+		{
+			name:       "no owner and no org segment errors",
+			ownerLogin: "",
+			team:       "platform",
+			wantErr:    "team \"platform\" must be a slug, an `org/team` path, a nested `org/team/child` path, or `id:<team-id>`",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, org, slugs, err := parseTeamRef(tt.ownerLogin, tt.team)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantID, id)
+			assert.Equal(t, tt.wantOrg, org)
+			assert.Equal(t, tt.wantSlugs, slugs)
+		})
+	}
+}
+
+func TestFindChildTeam(t *testing.T) {
+	nodes := []struct {
+		ID   githubv4.ID
+		Slug string
+		URL  string
+	}{
+		{ID: githubv4.ID("T_1"), Slug: "backend", URL: "https://github.com/orgs/acme/teams/backend"},
+		{ID: githubv4.ID("T_2"), Slug: "frontend", URL: "https://github.com/orgs/acme/teams/frontend"},
+	}
+
+	team, ok := findChildTeam(nodes, "backend")
+	require.True(t, ok)
+	assert.Equal(t, "T_1", team.ID)
+	assert.Equal(t, "backend", team.Slug)
+
+	_, ok = findChildTeam(nodes, "platform")
+	assert.False(t, ok)
+}
@@ -0,0 +1,293 @@
+package unlink
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/client"
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/queries"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/shurcooL/githubv4"
+	"github.com/spf13/cobra"
+)
+
+type unlinkOpts struct {
+	number    int32
+	owner     string
+	repo      string
+	team      string
+	all       bool
+	projectID string
+	repoID    string
+	teamID    string
+	format    string
+	exporter  cmdutil.Exporter
+}
+
+type unlinkConfig struct {
+	httpClient func() (*http.Client, error)
+	client     *queries.Client
+	opts       unlinkOpts
+	io         *iostreams.IOStreams
+}
+
+type unlinkProjectFromRepoMutation struct {
+	UnlinkProjectV2FromRepository struct {
+		Repository queries.Repository `graphql:"repository"`
+	} `graphql:"unlinkProjectV2FromRepository(input:$input)"`
+}
+
+type unlinkProjectFromTeamMutation struct {
+	UnlinkProjectV2FromTeam struct {
+		Team queries.Team `graphql:"team"`
+	} `graphql:"unlinkProjectV2FromTeam(input:$input)"`
+}
+
+func NewCmdUnlink(f *cmdutil.Factory, runF func(config unlinkConfig) error) *cobra.Command {
+	opts := unlinkOpts{}
+	unlinkCmd := &cobra.Command{
+		Short: "Unlink a project from a repository or a team",
+		Use:   "unlink [<number>] [flag]",
+		Example: heredoc.Doc(`
+			# unlink monalisa's project 1 from her repository "my_repo"
+			gh project unlink 1 --owner monalisa --repo my_repo
+
+			# unlink monalisa's organization's project 1 from her team "my_team"
+			gh project unlink 1 --owner my_organization --team my_team
+
+			# unlink every repository and team currently linked to monalisa's project 1
+			gh project unlink 1 --owner monalisa --all
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := client.New(f)
+			if err != nil {
+				return err
+			}
+
+			if len(args) == 1 {
+				num, err := strconv.ParseInt(args[0], 10, 32)
+				if err != nil {
+					return cmdutil.FlagErrorf("invalid number: %v", args[0])
+				}
+				opts.number = int32(num)
+			}
+
+			config := unlinkConfig{
+				httpClient: f.HttpClient,
+				client:     client,
+				opts:       opts,
+				io:         f.IOStreams,
+			}
+
+			if err := validateUnlinkOpts(config.opts); err != nil {
+				return err
+			}
+
+			// allow testing of the command without actually running it
+			if runF != nil {
+				return runF(config)
+			}
+			return runUnlink(config)
+		},
+	}
+
+	unlinkCmd.Flags().StringVar(&opts.owner, "owner", "", "Login of the owner. Use \"@me\" for the current user.")
+	unlinkCmd.Flags().StringVarP(&opts.repo, "repo", "R", "", "The repository to be unlinked from this project")
+	unlinkCmd.Flags().StringVarP(&opts.team, "team", "T", "", "The team to be unlinked from this project")
+	unlinkCmd.Flags().BoolVar(&opts.all, "all", false, "Unlink every repository or team currently linked to this project")
+	cmdutil.AddFormatFlags(unlinkCmd, &opts.exporter)
+
+	return unlinkCmd
+}
+
+// validateUnlinkOpts checks that exactly one of --repo, --team, or --all was
+// given.
+func validateUnlinkOpts(opts unlinkOpts) error {
+	if opts.repo != "" && opts.team != "" {
+		return fmt.Errorf("specify only one of `--repo` or `--team`")
+	} else if opts.all {
+		if opts.repo != "" || opts.team != "" {
+			return fmt.Errorf("specify either `--all` or `--repo`/`--team`, not both")
+		}
+	} else if opts.repo == "" && opts.team == "" {
+		return fmt.Errorf("specify one of `--repo`, `--team`, or `--all`")
+	}
+	return nil
+}
+
+func runUnlink(config unlinkConfig) error {
+	owner, project, c, err := config.client.ResolveOwnerProject(config.io, config.httpClient, config.opts.owner, config.opts.number)
+	if err != nil {
+		return err
+	}
+	config.opts.projectID = project.ID
+
+	if config.opts.all {
+		return unlinkAll(project, config)
+	} else if config.opts.repo != "" {
+		return unlinkRepo(c, owner, config)
+	} else if config.opts.team != "" {
+		return unlinkTeam(owner, config)
+	}
+	return nil
+}
+
+func unlinkRepo(c *api.Client, owner *queries.Owner, config unlinkConfig) error {
+	repo, err := api.GitHubRepo(c, ghrepo.New(owner.Login, config.opts.repo))
+	if err != nil {
+		return err
+	}
+	config.opts.repoID = repo.ID
+
+	query, variable := unlinkRepoArgs(config)
+	err = config.client.Mutate("UnlinkProjectV2FromRepository", query, variable)
+	if err != nil {
+		return err
+	}
+
+	if config.opts.exporter != nil {
+		return config.opts.exporter.Write(config.io, query.UnlinkProjectV2FromRepository.Repository)
+	}
+	return printResults(config, query.UnlinkProjectV2FromRepository.Repository.URL)
+}
+
+func unlinkTeam(owner *queries.Owner, config unlinkConfig) error {
+	team, err := config.client.ResolveTeam(owner.Login, config.opts.team)
+	if err != nil {
+		return err
+	}
+	config.opts.teamID = team.ID
+
+	query, variable := unlinkTeamArgs(config)
+	err = config.client.Mutate("UnlinkProjectV2FromTeam", query, variable)
+	if err != nil {
+		return err
+	}
+
+	if config.opts.exporter != nil {
+		return config.opts.exporter.Write(config.io, query.UnlinkProjectV2FromTeam.Team)
+	}
+	return printResults(config, query.UnlinkProjectV2FromTeam.Team.URL)
+}
+
+// unlinkResult is the outcome of unlinking a single repo or team from a
+// project, reported back to the user once an --all run has finished.
+type unlinkResult struct {
+	Target string `json:"target"`
+	Kind   string `json:"kind"`
+	Status string `json:"status"`
+	URL    string `json:"url,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+func (ur unlinkResult) ExportData(_ []string) map[string]interface{} {
+	return map[string]interface{}{
+		"target": ur.Target,
+		"kind":   ur.Kind,
+		"status": ur.Status,
+		"url":    ur.URL,
+		"error":  ur.Error,
+	}
+}
+
+// unlinkAll detaches every repository or team currently linked to the
+// project, discovered via a paginated query against ProjectV2.repositories
+// or ProjectV2.teams. A failed mutation doesn't abort the rest of the
+// targets; it's recorded and reported alongside the successes.
+func unlinkAll(project *queries.Project, config unlinkConfig) error {
+	var results []unlinkResult
+
+	repos, err := config.client.ProjectRepositories(project)
+	if err != nil {
+		return err
+	}
+	for _, repo := range repos {
+		config.opts.repoID = repo.ID
+		query, variable := unlinkRepoArgs(config)
+		if err := config.client.Mutate("UnlinkProjectV2FromRepository", query, variable); err != nil {
+			results = append(results, unlinkResult{Target: repo.NameWithOwner, Kind: "repo", Status: "failed", Error: err.Error()})
+			continue
+		}
+		results = append(results, unlinkResult{Target: repo.NameWithOwner, Kind: "repo", Status: "unlinked", URL: query.UnlinkProjectV2FromRepository.Repository.URL})
+	}
+
+	teams, err := config.client.ProjectTeams(project)
+	if err != nil {
+		return err
+	}
+	for _, team := range teams {
+		config.opts.teamID = team.ID
+		query, variable := unlinkTeamArgs(config)
+		if err := config.client.Mutate("UnlinkProjectV2FromTeam", query, variable); err != nil {
+			results = append(results, unlinkResult{Target: team.Slug, Kind: "team", Status: "failed", Error: err.Error()})
+			continue
+		}
+		results = append(results, unlinkResult{Target: team.Slug, Kind: "team", Status: "unlinked", URL: query.UnlinkProjectV2FromTeam.Team.URL})
+	}
+
+	return reportUnlinkResults(config, results)
+}
+
+// reportUnlinkResults prints or exports the outcome of an --all run. It only
+// returns an error when every target failed, matching the partial-failure
+// tolerance of reportLinkResults.
+func reportUnlinkResults(config unlinkConfig, results []unlinkResult) error {
+	failed := 0
+	for _, result := range results {
+		if result.Status == "failed" {
+			failed++
+		}
+	}
+
+	if config.opts.exporter != nil {
+		if err := config.opts.exporter.Write(config.io, results); err != nil {
+			return err
+		}
+	} else {
+		for _, result := range results {
+			switch {
+			case result.Status == "failed":
+				fmt.Fprintf(config.io.ErrOut, "X %s (%s): %s\n", result.Target, result.Kind, result.Error)
+			case config.io.IsStdoutTTY():
+				fmt.Fprintf(config.io.Out, "✓ %s (%s): %s\n", result.Target, result.Kind, result.URL)
+			}
+		}
+	}
+
+	if len(results) > 0 && failed == len(results) {
+		return fmt.Errorf("failed to unlink all %d target(s)", failed)
+	}
+	return nil
+}
+
+func unlinkRepoArgs(config unlinkConfig) (*unlinkProjectFromRepoMutation, map[string]interface{}) {
+	return &unlinkProjectFromRepoMutation{}, map[string]interface{}{
+		"input": githubv4.UnlinkProjectV2FromRepositoryInput{
+			ProjectID:    githubv4.ID(config.opts.projectID),
+			RepositoryID: githubv4.ID(config.opts.repoID),
+		},
+	}
+}
+
+func unlinkTeamArgs(config unlinkConfig) (*unlinkProjectFromTeamMutation, map[string]interface{}) {
+	return &unlinkProjectFromTeamMutation{}, map[string]interface{}{
+		"input": githubv4.UnlinkProjectV2FromTeamInput{
+			ProjectID: githubv4.ID(config.opts.projectID),
+			TeamID:    githubv4.ID(config.opts.teamID),
+		},
+	}
+}
+
+func printResults(config unlinkConfig, url string) error {
+	if !config.io.IsStdoutTTY() {
+		return nil
+	}
+
+	_, err := fmt.Fprintf(config.io.Out, "%s\n", url)
+	return err
+}
@@ -0,0 +1,138 @@
+package unlink
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateUnlinkOpts(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    unlinkOpts
+		wantErr string
+	}{
+		{
+			name:    "repo and team conflict",
+			opts:    unlinkOpts{repo: "my_repo", team: "my_team"},
+			wantErr: "specify only one of `--repo` or `--team`",
+		},
+		{
+			name:    "all and repo conflict",
+			opts:    unlinkOpts{all: true, repo: "my_repo"},
+			wantErr: "specify either `--all` or `--repo`/`--team`, not both",
+		},
+		{
+			name:    "all and team conflict",
+			opts:    unlinkOpts{all: true, team: "my_team"},
+			wantErr: "specify either `--all` or `--repo`/`--team`, not both",
+		},
+		{
+			name:    "none of repo, team, or all",
+			opts:    unlinkOpts{},
+			wantErr: "specify one of `--repo`, `--team`, or `--all`",
+		},
+		{
+			name: "repo only is valid",
+			opts: unlinkOpts{repo: "my_repo"},
+		},
+		{
+			name: "team only is valid",
+			opts: unlinkOpts{team: "my_team"},
+		},
+		{
+			name: "all only is valid",
+			opts: unlinkOpts{all: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateUnlinkOpts(tt.opts)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+// fakeExporter is a no-op cmdutil.Exporter used to exercise the --format
+// json path of reportUnlinkResults without a real JSON exporter.
+type fakeExporter struct{}
+
+func (fakeExporter) Fields() []string { return nil }
+
+func (fakeExporter) Write(*iostreams.IOStreams, interface{}) error { return nil }
+
+func TestReportUnlinkResults(t *testing.T) {
+	tests := []struct {
+		name       string
+		results    []unlinkResult
+		exporter   bool
+		wantErr    string
+		wantErrOut string
+	}{
+		{
+			name: "all succeed",
+			results: []unlinkResult{
+				{Target: "monalisa/repo-a", Kind: "repo", Status: "unlinked", URL: "https://github.com/monalisa/repo-a"},
+				{Target: "team-a", Kind: "team", Status: "unlinked", URL: "https://github.com/orgs/monalisa/teams/team-a"},
+			},
+		},
+		{
+			name: "partial failure does not error",
+			results: []unlinkResult{
+				{Target: "monalisa/repo-a", Kind: "repo", Status: "unlinked", URL: "https://github.com/monalisa/repo-a"},
+				{Target: "monalisa/repo-b", Kind: "repo", Status: "failed", Error: "not found"},
+			},
+			wantErrOut: "not found",
+		},
+		{
+			name: "every target failing errors",
+			results: []unlinkResult{
+				{Target: "monalisa/repo-a", Kind: "repo", Status: "failed", Error: "not found"},
+				{Target: "monalisa/repo-b", Kind: "repo", Status: "failed", Error: "not found"},
+			},
+			wantErr: "failed to unlink all 2 target(s)",
+		},
+		{
+			name:    "no targets does not error",
+			results: nil,
+		},
+		{
+			name: "every target failing still errors with --format json",
+			results: []unlinkResult{
+				{Target: "monalisa/repo-a", Kind: "repo", Status: "failed", Error: "not found"},
+				{Target: "monalisa/repo-b", Kind: "repo", Status: "failed", Error: "not found"},
+			},
+			exporter: true,
+			wantErr:  "failed to unlink all 2 target(s)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, stderr := iostreams.Test()
+			ios.SetStdoutTTY(true)
+			config := unlinkConfig{io: ios}
+			if tt.exporter {
+				config.opts.exporter = fakeExporter{}
+			}
+
+			err := reportUnlinkResults(config, tt.results)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			if tt.wantErrOut != "" {
+				assert.True(t, strings.Contains(stderr.String(), tt.wantErrOut))
+			}
+		})
+	}
+}